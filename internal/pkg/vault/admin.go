@@ -0,0 +1,62 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// HealthCheck checks whether the Vault instance is up and returns its HTTP status code.
+func (c *Client) HealthCheck() (int, error) {
+	resp, err := c.doRequest(http.MethodGet, "/v1/sys/health", nil, nil)
+	if resp == nil {
+		return 0, fmt.Errorf("failed to reach Vault health endpoint: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// Init initializes a new Vault instance with the given Shamir secret sharing parameters.
+func (c *Client) Init(secretThreshold int, secretShares int) (types.InitResponse, error) {
+	var response types.InitResponse
+	request := map[string]int{
+		"secret_threshold": secretThreshold,
+		"secret_shares":    secretShares,
+	}
+	if _, err := c.doRequest(http.MethodPut, "/v1/sys/init", request, &response); err != nil {
+		return types.InitResponse{}, fmt.Errorf("failed to initialize Vault: %w", err)
+	}
+	return response, nil
+}
+
+// Unseal submits unseal keys to Vault until it transitions out of the sealed state.
+func (c *Client) Unseal(keysBase64 []string) error {
+	for _, key := range keysBase64 {
+		var response struct {
+			Sealed bool `json:"sealed"`
+		}
+		request := map[string]string{"key": key}
+		if _, err := c.doRequest(http.MethodPut, "/v1/sys/unseal", request, &response); err != nil {
+			return fmt.Errorf("failed to unseal Vault: %w", err)
+		}
+		if !response.Sealed {
+			return nil
+		}
+	}
+	return nil
+}
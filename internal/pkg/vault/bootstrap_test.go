@@ -0,0 +1,147 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestExistingConsulSecretIDReturnsEmptyOnNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	secretID, err := client.existingConsulSecretID("caller-token", "secret/edgex/consul")
+	if err != nil {
+		t.Fatalf("expected no error for a 404 response, got: %v", err)
+	}
+	if secretID != "" {
+		t.Errorf("expected an empty SecretID, got %q", secretID)
+	}
+}
+
+func TestExistingConsulSecretIDReturnsErrorOnOtherFailures(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.existingConsulSecretID("caller-token", "secret/edgex/consul"); err == nil {
+		t.Fatal("expected an error for a 500 response, got nil")
+	}
+}
+
+func TestExistingConsulSecretIDReturnsPersistedValue(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"SecretID":"existing-secret-id"}}`))
+	})
+
+	secretID, err := client.existingConsulSecretID("caller-token", "secret/edgex/consul")
+	if err != nil {
+		t.Fatalf("existingConsulSecretID returned an error: %v", err)
+	}
+	if secretID != "existing-secret-id" {
+		t.Errorf("expected SecretID %q, got %q", "existing-secret-id", secretID)
+	}
+}
+
+// consulHostPort parses a httptest.Server's URL into the host/port pair BootstrapConsulACL expects.
+func consulHostPort(t *testing.T, serverURL string) (string, int) {
+	t.Helper()
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatalf("failed to parse Consul test server URL: %v", err)
+	}
+	port, err := strconv.Atoi(parsed.Port())
+	if err != nil {
+		t.Fatalf("failed to parse Consul test server port: %v", err)
+	}
+	return parsed.Hostname(), port
+}
+
+func TestBootstrapAndConfigureConsulBootstrapsWhenNoSecretIDExists(t *testing.T) {
+	var storedSecretID string
+	var configuredToken string
+
+	vaultClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/secret/edgex/consul":
+			storedSecretID = "new-secret-id"
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/consul/config/access":
+			configuredToken = r.Header.Get("X-Vault-Token")
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	consulServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessorID":"accessor-1","SecretID":"new-secret-id"}`))
+	}))
+	t.Cleanup(consulServer.Close)
+	consulHost, consulPort := consulHostPort(t, consulServer.URL)
+
+	secretID, err := vaultClient.BootstrapAndConfigureConsul("caller-token", "secret/edgex/consul", consulHost, consulPort)
+	if err != nil {
+		t.Fatalf("BootstrapAndConfigureConsul returned an error: %v", err)
+	}
+	if secretID != "new-secret-id" {
+		t.Errorf("expected SecretID %q, got %q", "new-secret-id", secretID)
+	}
+	if storedSecretID != "new-secret-id" {
+		t.Errorf("expected the new SecretID to be persisted, got %q", storedSecretID)
+	}
+	if configuredToken != "caller-token" {
+		t.Errorf("expected Consul access to be configured with the caller's token, got %q", configuredToken)
+	}
+}
+
+func TestBootstrapAndConfigureConsulPropagatesReadFailureInsteadOfReBootstrapping(t *testing.T) {
+	var bootstrapped bool
+
+	vaultClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	consulServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bootstrapped = true
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessorID":"accessor-1","SecretID":"new-secret-id"}`))
+	}))
+	t.Cleanup(consulServer.Close)
+	consulHost, consulPort := consulHostPort(t, consulServer.URL)
+
+	_, err := vaultClient.BootstrapAndConfigureConsul("caller-token", "secret/edgex/consul", consulHost, consulPort)
+	if err == nil {
+		t.Fatal("expected an error when reading the existing SecretID fails, got nil")
+	}
+	if bootstrapped {
+		t.Error("expected BootstrapAndConfigureConsul not to bootstrap Consul's ACLs when the KV read failed for a reason other than a missing entry")
+	}
+}
@@ -0,0 +1,137 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package vault contains the concrete implementation of the secrets.SecretClient and
+// secrets.SecretStoreClient interfaces backed by HashiCorp Vault.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// Client is the Vault backed implementation shared by the SecretClient and SecretStoreClient
+// interfaces. It owns the HTTP connection details and the token/namespace currently in use.
+type Client struct {
+	httpClient *http.Client
+	scheme     string
+	host       string
+	authToken  string
+	namespace  string
+}
+
+// NewClient creates a Client configured to talk to the Vault instance described by config,
+// authenticating with the given token.
+func NewClient(config types.SecretConfig, httpClient *http.Client, authToken string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		scheme:     config.Protocol,
+		host:       fmt.Sprintf("%s:%d", config.Host, config.Port),
+		authToken:  authToken,
+		namespace:  config.Namespace,
+	}
+}
+
+// SetNamespace sets the Vault Enterprise namespace used for all subsequent requests made by this
+// Client. Passing an empty string reverts to the root namespace.
+func (c *Client) SetNamespace(namespace string) {
+	c.namespace = namespace
+}
+
+// SetAuthToken sets the internal Auth Token with the new value specified.
+func (c *Client) SetAuthToken(_ context.Context, token string) error {
+	c.authToken = token
+	return nil
+}
+
+// doRequest issues an HTTP request against the Vault API at the given path, marshaling body (if
+// non-nil) as the JSON request payload and unmarshaling the response into out (if non-nil). The
+// Client's own auth token and namespace, if any, are attached to the request. doRequest reads
+// c.authToken/c.namespace without synchronization, so it must not run concurrently with
+// SetAuthToken/SetNamespace; it always uses whichever value was set most recently. It is meant
+// for the single-identity SecretClient methods, not for admin calls made with a caller-supplied
+// token (see doRequestWithToken).
+func (c *Client) doRequest(method string, path string, body interface{}, out interface{}) (*http.Response, error) {
+	return c.request(method, path, c.authToken, c.namespace, body, out)
+}
+
+// doRequestWithNamespace behaves like doRequest but allows the caller to override the namespace
+// used for this single request, without mutating the Client's configured namespace.
+func (c *Client) doRequestWithNamespace(method string, path string, namespace string, body interface{}, out interface{}) (*http.Response, error) {
+	return c.request(method, path, c.authToken, namespace, body, out)
+}
+
+// doRequestWithToken behaves like doRequest but authenticates this single request with token
+// instead of the Client's own auth token, without mutating shared Client state. This is what the
+// admin-style SecretStoreClient methods use to inject their caller-supplied token, so that
+// concurrent calls made with different tokens on the same Client cannot race with one another.
+func (c *Client) doRequestWithToken(method string, path string, token string, body interface{}, out interface{}) (*http.Response, error) {
+	return c.request(method, path, token, c.namespace, body, out)
+}
+
+// request issues an HTTP request against the Vault API at the given path, authenticating with
+// token and scoping it to namespace (if non-empty), marshaling body (if non-nil) as the JSON
+// request payload and unmarshaling the response into out (if non-nil).
+func (c *Client) request(method string, path string, token string, namespace string, body interface{}, out interface{}) (*http.Response, error) {
+	url := fmt.Sprintf("%s://%s%s", c.scheme, c.host, path)
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body for %s: %w", path, err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", path, err)
+	}
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp, fmt.Errorf("request %s %s failed with status code %d", method, path, resp.StatusCode)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+			return resp, fmt.Errorf("failed to decode response from %s: %w", path, err)
+		}
+	}
+
+	return resp, nil
+}
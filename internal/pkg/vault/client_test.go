@@ -0,0 +1,140 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	parsed, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+
+	port, err := strconv.Atoi(parsed.Port())
+	if err != nil {
+		t.Fatalf("failed to parse test server port: %v", err)
+	}
+
+	return NewClient(types.SecretConfig{
+		Protocol: parsed.Scheme,
+		Host:     parsed.Hostname(),
+		Port:     port,
+	}, server.Client(), "root-token")
+}
+
+func TestDoRequestSetsAuthTokenHeader(t *testing.T) {
+	var gotToken string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Vault-Token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := client.doRequest(http.MethodGet, "/v1/secret/foo", nil, nil); err != nil {
+		t.Fatalf("doRequest returned an error: %v", err)
+	}
+	if gotToken != "root-token" {
+		t.Errorf("expected X-Vault-Token %q, got %q", "root-token", gotToken)
+	}
+}
+
+func TestDoRequestWithTokenDoesNotMutateClientAuthToken(t *testing.T) {
+	var gotToken string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Vault-Token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := client.doRequestWithToken(http.MethodGet, "/v1/secret/foo", "caller-token", nil, nil); err != nil {
+		t.Fatalf("doRequestWithToken returned an error: %v", err)
+	}
+	if gotToken != "caller-token" {
+		t.Errorf("expected X-Vault-Token %q, got %q", "caller-token", gotToken)
+	}
+	if client.authToken != "root-token" {
+		t.Errorf("doRequestWithToken must not mutate the Client's own auth token, got %q", client.authToken)
+	}
+}
+
+func TestDoRequestWithNamespaceSetsNamespaceHeaderWithoutMutatingClient(t *testing.T) {
+	var gotNamespace string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotNamespace = r.Header.Get("X-Vault-Namespace")
+		w.WriteHeader(http.StatusOK)
+	})
+	client.SetNamespace("root-namespace")
+
+	if _, err := client.doRequestWithNamespace(http.MethodGet, "/v1/secret/foo", "tenant-namespace", nil, nil); err != nil {
+		t.Fatalf("doRequestWithNamespace returned an error: %v", err)
+	}
+	if gotNamespace != "tenant-namespace" {
+		t.Errorf("expected X-Vault-Namespace %q, got %q", "tenant-namespace", gotNamespace)
+	}
+	if client.namespace != "root-namespace" {
+		t.Errorf("doRequestWithNamespace must not mutate the Client's own namespace, got %q", client.namespace)
+	}
+}
+
+func TestDoRequestOmitsNamespaceHeaderWhenUnset(t *testing.T) {
+	var sawHeader bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, sawHeader = r.Header["X-Vault-Namespace"]
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := client.doRequest(http.MethodGet, "/v1/secret/foo", nil, nil); err != nil {
+		t.Fatalf("doRequest returned an error: %v", err)
+	}
+	if sawHeader {
+		t.Error("expected X-Vault-Namespace header to be omitted when no namespace is set")
+	}
+}
+
+func TestRequestDecodesJSONResponseBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"foo":"bar"}}`))
+	})
+
+	var out kvReadResponse
+	if _, err := client.doRequest(http.MethodGet, "/v1/secret/foo", nil, &out); err != nil {
+		t.Fatalf("doRequest returned an error: %v", err)
+	}
+	if out.Data["foo"] != "bar" {
+		t.Errorf("expected decoded response data[foo] = bar, got %q", out.Data["foo"])
+	}
+}
+
+func TestRequestReturnsErrorOnNonSuccessStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	if _, err := client.doRequest(http.MethodGet, "/v1/secret/foo", nil, nil); err == nil {
+		t.Fatal("expected an error for a 403 response, got nil")
+	}
+}
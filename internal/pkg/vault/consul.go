@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+const defaultConsulMountPoint = "consul"
+
+// consulSecretIDKey is the key under which a bootstrapped Consul ACL token's SecretID is
+// persisted in the secret store by BootstrapAndConfigureConsul.
+const consulSecretIDKey = "SecretID"
+
+// errConsulACLAlreadyBootstrapped is returned by BootstrapConsulACL when Consul's ACL system has
+// already been bootstrapped, which Consul reports as a 403 response from acl/bootstrap.
+var errConsulACLAlreadyBootstrapped = errors.New("Consul ACLs are already bootstrapped")
+
+type consulTokenResponse struct {
+	Data struct {
+		Token string `json:"token"`
+	} `json:"data"`
+}
+
+// GenerateConsulToken generates a new Consul token based on the given serviceKey.
+func (c *Client) GenerateConsulToken(serviceKey string) (string, error) {
+	var response consulTokenResponse
+	path := fmt.Sprintf("/v1/%s/creds/%s", defaultConsulMountPoint, serviceKey)
+	if _, err := c.doRequest(http.MethodGet, path, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to generate Consul token for %s: %w", serviceKey, err)
+	}
+	return response.Data.Token, nil
+}
+
+// GenerateConsulTokenForRole generates a new Consul token for roleName and returns the full lease
+// (accessor, lease ID, lease duration, renewable) so the caller can renew or revoke it instead of
+// always minting a new token.
+func (c *Client) GenerateConsulTokenForRole(roleName string) (types.ConsulTokenResponse, error) {
+	var response types.ConsulTokenResponse
+	path := fmt.Sprintf("/v1/%s/creds/%s", defaultConsulMountPoint, roleName)
+	if _, err := c.doRequest(http.MethodGet, path, nil, &response); err != nil {
+		return types.ConsulTokenResponse{}, fmt.Errorf("failed to generate Consul token for role %s: %w", roleName, err)
+	}
+	return response, nil
+}
+
+// EnableConsulSecretEngine enables the Consul secrets engine at mountPoint with the given default
+// lease TTL.
+func (c *Client) EnableConsulSecretEngine(token string, mountPoint string, defaultLeaseTTL string) error {
+	request := map[string]interface{}{
+		"type": "consul",
+		"config": map[string]string{
+			"default_lease_ttl": defaultLeaseTTL,
+		},
+	}
+	path := fmt.Sprintf("/v1/sys/mounts/%s", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to enable Consul secret engine at %s: %w", mountPoint, err)
+	}
+	return nil
+}
+
+// ConfigureConsulAccess configures the Consul secrets engine's connection to the Consul agent.
+func (c *Client) ConfigureConsulAccess(secretStoreToken string, bootstrapACLToken string, consulHost string, consulPort int) error {
+	request := map[string]interface{}{
+		"address": fmt.Sprintf("%s:%d", consulHost, consulPort),
+		"token":   bootstrapACLToken,
+	}
+	path := fmt.Sprintf("/v1/%s/config/access", defaultConsulMountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, secretStoreToken, request, nil); err != nil {
+		return fmt.Errorf("failed to configure Consul access: %w", err)
+	}
+	return nil
+}
+
+// BootstrapConsulACL calls Consul's acl/bootstrap endpoint directly on the Consul agent at
+// consulHost:consulPort, returning the initial management token minted for the cluster. Consul's
+// ACL system may only be bootstrapped once; subsequent calls fail with a 403 response.
+func (c *Client) BootstrapConsulACL(consulHost string, consulPort int) (types.BootStrapACLTokenInfo, error) {
+	url := fmt.Sprintf("http://%s:%d/v1/acl/bootstrap", consulHost, consulPort)
+
+	req, err := http.NewRequest(http.MethodPut, url, nil)
+	if err != nil {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("failed to create Consul ACL bootstrap request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("failed to bootstrap Consul ACLs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("failed to read Consul ACL bootstrap response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("%w: %s", errConsulACLAlreadyBootstrapped, bytes.TrimSpace(body))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("Consul ACL bootstrap failed with status code %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var info types.BootStrapACLTokenInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return types.BootStrapACLTokenInfo{}, fmt.Errorf("failed to decode Consul ACL bootstrap response: %w", err)
+	}
+
+	return info, nil
+}
+
+// BootstrapAndConfigureConsul bootstraps Consul's ACL system, persists the resulting management
+// token's SecretID into the secret store at subPath, and configures the Consul secrets engine to
+// use it. If Consul has already been bootstrapped, the existing SecretID is read back from
+// subPath instead so that re-running setup does not orphan or re-bootstrap tokens; subPath must
+// already hold a valid SecretID in that case.
+func (c *Client) BootstrapAndConfigureConsul(secretStoreToken string, subPath string, consulHost string, consulPort int) (string, error) {
+	secretID, err := c.existingConsulSecretID(secretStoreToken, subPath)
+	if err != nil {
+		return "", err
+	}
+
+	if secretID == "" {
+		info, bootstrapErr := c.BootstrapConsulACL(consulHost, consulPort)
+		if bootstrapErr != nil {
+			if !errors.Is(bootstrapErr, errConsulACLAlreadyBootstrapped) {
+				return "", bootstrapErr
+			}
+			return "", fmt.Errorf("Consul ACLs are already bootstrapped and no existing token was found at %s: %w", subPath, bootstrapErr)
+		}
+		secretID = info.SecretID
+
+		if err := c.storeSecretsWithToken(secretStoreToken, subPath, map[string]string{consulSecretIDKey: secretID}); err != nil {
+			return "", fmt.Errorf("failed to persist bootstrapped Consul ACL token: %w", err)
+		}
+	}
+
+	if err := c.ConfigureConsulAccess(secretStoreToken, secretID, consulHost, consulPort); err != nil {
+		return "", err
+	}
+
+	return secretID, nil
+}
+
+// existingConsulSecretID reads a previously persisted Consul ACL SecretID from subPath, returning
+// an empty string if subPath does not exist yet (the expected case prior to the first bootstrap).
+// Any other error, e.g. a network failure or an invalid secretStoreToken, is returned to the
+// caller rather than being treated as a missing SecretID.
+func (c *Client) existingConsulSecretID(secretStoreToken string, subPath string) (string, error) {
+	var response kvReadResponse
+	path := fmt.Sprintf("/v1/%s", subPath)
+	resp, err := c.doRequestWithToken(http.MethodGet, path, secretStoreToken, nil, &response)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read existing Consul ACL token from %s: %w", subPath, err)
+	}
+	return response.Data[consulSecretIDKey], nil
+}
+
+// CreateRole creates a role in the Consul secrets engine mounted at mountPoint. GenerateConsulToken
+// and GenerateConsulTokenForRole mint tokens only against roles on the "consul" mount.
+func (c *Client) CreateRole(secretStoreToken string, mountPoint string, consulRole types.ConsulRole) error {
+	path := fmt.Sprintf("/v1/%s/roles/%s", mountPoint, consulRole.Name)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, secretStoreToken, consulRole, nil); err != nil {
+		return fmt.Errorf("failed to create Consul role %s: %w", consulRole.Name, err)
+	}
+	return nil
+}
+
+// ReadConsulRole reads the named role from the Consul secrets engine mounted at mountPoint.
+func (c *Client) ReadConsulRole(token string, mountPoint string, roleName string) (types.ConsulRole, error) {
+	var response struct {
+		Data types.ConsulRole `json:"data"`
+	}
+	path := fmt.Sprintf("/v1/%s/roles/%s", mountPoint, roleName)
+	if _, err := c.doRequestWithToken(http.MethodGet, path, token, nil, &response); err != nil {
+		return types.ConsulRole{}, fmt.Errorf("failed to read Consul role %s: %w", roleName, err)
+	}
+	return response.Data, nil
+}
+
+// ListConsulRoles lists the names of the roles configured on the Consul secrets engine mounted at
+// mountPoint.
+func (c *Client) ListConsulRoles(token string, mountPoint string) ([]string, error) {
+	var response struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("/v1/%s/roles?list=true", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodGet, path, token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list Consul roles at %s: %w", mountPoint, err)
+	}
+	return response.Data.Keys, nil
+}
+
+// DeleteConsulRole deletes the named role from the Consul secrets engine mounted at mountPoint.
+func (c *Client) DeleteConsulRole(token string, mountPoint string, roleName string) error {
+	path := fmt.Sprintf("/v1/%s/roles/%s", mountPoint, roleName)
+	if _, err := c.doRequestWithToken(http.MethodDelete, path, token, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete Consul role %s: %w", roleName, err)
+	}
+	return nil
+}
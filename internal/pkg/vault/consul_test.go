@@ -0,0 +1,73 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+func TestCreateRoleUsesGivenMountPointAndToken(t *testing.T) {
+	var gotPath, gotToken string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotToken = r.Header.Get("X-Vault-Token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.CreateRole("caller-token", "consul-tenant-a", types.ConsulRole{Name: "my-role"})
+	if err != nil {
+		t.Fatalf("CreateRole returned an error: %v", err)
+	}
+	if gotPath != "/v1/consul-tenant-a/roles/my-role" {
+		t.Errorf("expected path to use the given mountPoint, got %q", gotPath)
+	}
+	if gotToken != "caller-token" {
+		t.Errorf("expected X-Vault-Token %q, got %q", "caller-token", gotToken)
+	}
+	if client.authToken != "root-token" {
+		t.Errorf("CreateRole must not mutate the Client's own auth token, got %q", client.authToken)
+	}
+}
+
+func TestReadAndDeleteConsulRoleUseGivenToken(t *testing.T) {
+	var gotToken string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Vault-Token")
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"name":"my-role"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.ReadConsulRole("caller-token", "consul-tenant-a", "my-role"); err != nil {
+		t.Fatalf("ReadConsulRole returned an error: %v", err)
+	}
+	if gotToken != "caller-token" {
+		t.Errorf("expected ReadConsulRole to use the given token, got %q", gotToken)
+	}
+
+	if err := client.DeleteConsulRole("caller-token", "consul-tenant-a", "my-role"); err != nil {
+		t.Fatalf("DeleteConsulRole returned an error: %v", err)
+	}
+	if gotToken != "caller-token" {
+		t.Errorf("expected DeleteConsulRole to use the given token, got %q", gotToken)
+	}
+}
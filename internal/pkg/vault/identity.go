@@ -0,0 +1,191 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CreateOrUpdateIdentity creates or updates a Vault identity entity and returns its ID.
+func (c *Client) CreateOrUpdateIdentity(token string, name string, metadata map[string]string, policies []string) (string, error) {
+	var response struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	request := map[string]interface{}{
+		"name":     name,
+		"metadata": metadata,
+		"policies": policies,
+	}
+	if _, err := c.doRequestWithToken(http.MethodPost, "/v1/identity/entity", token, request, &response); err != nil {
+		return "", fmt.Errorf("failed to create or update identity %s: %w", name, err)
+	}
+	return response.Data.ID, nil
+}
+
+// DeleteIdentity deletes the named Vault identity entity.
+func (c *Client) DeleteIdentity(token string, name string) error {
+	path := fmt.Sprintf("/v1/identity/entity/name/%s", name)
+	if _, err := c.doRequestWithToken(http.MethodDelete, path, token, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete identity %s: %w", name, err)
+	}
+	return nil
+}
+
+// LookupIdentity returns the ID of the named Vault identity entity.
+func (c *Client) LookupIdentity(token string, name string) (string, error) {
+	var response struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("/v1/identity/entity/name/%s", name)
+	if _, err := c.doRequestWithToken(http.MethodGet, path, token, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to lookup identity %s: %w", name, err)
+	}
+	return response.Data.ID, nil
+}
+
+// CheckAuthMethodEnabled reports whether the named auth method is enabled at mountPoint.
+func (c *Client) CheckAuthMethodEnabled(token string, mountPoint string, authType string) (bool, error) {
+	var response struct {
+		Data map[string]struct {
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequestWithToken(http.MethodGet, "/v1/sys/auth", token, nil, &response); err != nil {
+		return false, fmt.Errorf("failed to list auth methods: %w", err)
+	}
+
+	method, found := response.Data[fmt.Sprintf("%s/", mountPoint)]
+	return found && method.Type == authType, nil
+}
+
+// EnablePasswordAuth enables the userpass auth method at mountPoint.
+func (c *Client) EnablePasswordAuth(token string, mountPoint string) error {
+	request := map[string]string{"type": "userpass"}
+	path := fmt.Sprintf("/v1/sys/auth/%s", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to enable password auth at %s: %w", mountPoint, err)
+	}
+	return nil
+}
+
+// LookupAuthHandle returns the accessor handle for the auth method mounted at mountPoint.
+func (c *Client) LookupAuthHandle(token string, mountPoint string) (string, error) {
+	var response struct {
+		Data map[string]struct {
+			Accessor string `json:"accessor"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequestWithToken(http.MethodGet, "/v1/sys/auth", token, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to list auth methods: %w", err)
+	}
+
+	method, found := response.Data[fmt.Sprintf("%s/", mountPoint)]
+	if !found {
+		return "", fmt.Errorf("no auth method mounted at %s", mountPoint)
+	}
+	return method.Accessor, nil
+}
+
+// CreateOrUpdateUser creates or updates a userpass user at mountPoint.
+func (c *Client) CreateOrUpdateUser(token string, mountPoint string, username string, password string, tokenTTL string, tokenPolicies []string) error {
+	request := map[string]interface{}{
+		"password":       password,
+		"token_ttl":      tokenTTL,
+		"token_policies": tokenPolicies,
+	}
+	path := fmt.Sprintf("/v1/auth/%s/users/%s", mountPoint, username)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to create or update user %s: %w", username, err)
+	}
+	return nil
+}
+
+// DeleteUser deletes the named userpass user at mountPoint.
+func (c *Client) DeleteUser(token string, mountPoint string, username string) error {
+	path := fmt.Sprintf("/v1/auth/%s/users/%s", mountPoint, username)
+	if _, err := c.doRequestWithToken(http.MethodDelete, path, token, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user %s: %w", username, err)
+	}
+	return nil
+}
+
+// BindUserToIdentity binds the userpass alias for username to the given identity entity.
+func (c *Client) BindUserToIdentity(token string, identityId string, authHandle string, username string) error {
+	request := map[string]interface{}{
+		"name":           username,
+		"canonical_id":   identityId,
+		"mount_accessor": authHandle,
+	}
+	if _, err := c.doRequestWithToken(http.MethodPost, "/v1/identity/entity-alias", token, request, nil); err != nil {
+		return fmt.Errorf("failed to bind user %s to identity %s: %w", username, identityId, err)
+	}
+	return nil
+}
+
+// InternalServiceLogin authenticates against the given auth engine mount with a username and
+// password and returns the raw login response.
+func (c *Client) InternalServiceLogin(token string, authEngine string, username string, password string) (map[string]interface{}, error) {
+	var response map[string]interface{}
+	request := map[string]string{"password": password}
+	path := fmt.Sprintf("/v1/auth/%s/login/%s", authEngine, username)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, &response); err != nil {
+		return nil, fmt.Errorf("failed to login as %s: %w", username, err)
+	}
+	return response, nil
+}
+
+// CheckIdentityKeyExists reports whether the named identity token key exists.
+func (c *Client) CheckIdentityKeyExists(token string, keyName string) (bool, error) {
+	path := fmt.Sprintf("/v1/identity/oidc/key/%s", keyName)
+	resp, err := c.doRequestWithToken(http.MethodGet, path, token, nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check identity key %s: %w", keyName, err)
+	}
+	return true, nil
+}
+
+// CreateNamedIdentityKey creates a named identity token key using the given signing algorithm.
+func (c *Client) CreateNamedIdentityKey(token string, keyName string, algorithm string) error {
+	request := map[string]string{"algorithm": algorithm}
+	path := fmt.Sprintf("/v1/identity/oidc/key/%s", keyName)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to create identity key %s: %w", keyName, err)
+	}
+	return nil
+}
+
+// CreateOrUpdateIdentityRole creates or updates the named identity token role using keyName to
+// sign tokens rendered from template, valid for jwtTTL.
+func (c *Client) CreateOrUpdateIdentityRole(token string, roleName string, keyName string, template string, jwtTTL string) error {
+	request := map[string]string{
+		"key":      keyName,
+		"template": template,
+		"ttl":      jwtTTL,
+	}
+	path := fmt.Sprintf("/v1/identity/oidc/role/%s", roleName)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to create or update identity role %s: %w", roleName, err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetSelfJWT returns an encoded JWT for the current identity-based secret store token.
+func (c *Client) GetSelfJWT(serviceKey string) (string, error) {
+	var response struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("/v1/identity/oidc/token/%s", serviceKey)
+	if _, err := c.doRequest(http.MethodGet, path, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to retrieve self JWT for %s: %w", serviceKey, err)
+	}
+	return response.Data.Token, nil
+}
+
+// IsJWTValid evaluates a given JWT and returns true if it is valid (i.e. belongs to us and
+// current) or not.
+func (c *Client) IsJWTValid(jwt string) (bool, error) {
+	var response struct {
+		Data struct {
+			Valid bool `json:"valid"`
+		} `json:"data"`
+	}
+	request := map[string]string{"token": jwt}
+	if _, err := c.doRequest(http.MethodPost, "/v1/identity/oidc/introspect", request, &response); err != nil {
+		return false, fmt.Errorf("failed to validate JWT: %w", err)
+	}
+	return response.Data.Valid, nil
+}
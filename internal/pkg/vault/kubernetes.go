@@ -0,0 +1,71 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// EnableKubernetesAuth enables the Kubernetes auth method at mountPoint.
+func (c *Client) EnableKubernetesAuth(token string, mountPoint string) error {
+	request := map[string]string{"type": "kubernetes"}
+	path := fmt.Sprintf("/v1/sys/auth/%s", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to enable Kubernetes auth at %s: %w", mountPoint, err)
+	}
+	return nil
+}
+
+// ConfigureKubernetesAuth configures the Kubernetes auth method mounted at mountPoint with the
+// location of the Kubernetes API server and the details needed to validate service account JWTs.
+func (c *Client) ConfigureKubernetesAuth(token string, mountPoint string, cfg types.KubernetesAuthConfig) error {
+	path := fmt.Sprintf("/v1/auth/%s/config", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, cfg, nil); err != nil {
+		return fmt.Errorf("failed to configure Kubernetes auth at %s: %w", mountPoint, err)
+	}
+	return nil
+}
+
+// CreateOrUpdateKubernetesRole creates or updates a role on the Kubernetes auth method mounted at
+// mountPoint.
+func (c *Client) CreateOrUpdateKubernetesRole(token string, mountPoint string, roleName string, role types.KubernetesRole) error {
+	path := fmt.Sprintf("/v1/auth/%s/role/%s", mountPoint, roleName)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, role, nil); err != nil {
+		return fmt.Errorf("failed to create or update Kubernetes role %s: %w", roleName, err)
+	}
+	return nil
+}
+
+// LoginWithKubernetes authenticates the given Kubernetes service account JWT against role on the
+// Kubernetes auth method mounted at mountPoint, returning the "auth" section of the login
+// response (which holds the client_token).
+func (c *Client) LoginWithKubernetes(mountPoint string, role string, jwt string) (map[string]interface{}, error) {
+	var response struct {
+		Auth map[string]interface{} `json:"auth"`
+	}
+	request := map[string]string{
+		"role": role,
+		"jwt":  jwt,
+	}
+	path := fmt.Sprintf("/v1/auth/%s/login", mountPoint)
+	if _, err := c.doRequest(http.MethodPost, path, request, &response); err != nil {
+		return nil, fmt.Errorf("failed to login via Kubernetes auth role %s: %w", role, err)
+	}
+	return response.Auth, nil
+}
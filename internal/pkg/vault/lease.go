@@ -0,0 +1,57 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// RenewLease renews the lease identified by leaseID by increment, returning the new lease
+// duration Vault granted.
+func (c *Client) RenewLease(leaseID string, increment time.Duration) (types.LeaseResponse, error) {
+	var response types.LeaseResponse
+	request := map[string]interface{}{
+		"lease_id":  leaseID,
+		"increment": int(increment.Seconds()),
+	}
+	if _, err := c.doRequest(http.MethodPut, "/v1/sys/leases/renew", request, &response); err != nil {
+		return types.LeaseResponse{}, fmt.Errorf("failed to renew lease %s: %w", leaseID, err)
+	}
+	return response, nil
+}
+
+// RevokeLease revokes the lease identified by leaseID immediately.
+func (c *Client) RevokeLease(leaseID string) error {
+	request := map[string]string{"lease_id": leaseID}
+	if _, err := c.doRequest(http.MethodPut, "/v1/sys/leases/revoke", request, nil); err != nil {
+		return fmt.Errorf("failed to revoke lease %s: %w", leaseID, err)
+	}
+	return nil
+}
+
+// LookupLease returns the current metadata for the lease identified by leaseID.
+func (c *Client) LookupLease(leaseID string) (types.LeaseResponse, error) {
+	var response types.LeaseResponse
+	request := map[string]string{"lease_id": leaseID}
+	if _, err := c.doRequest(http.MethodPut, "/v1/sys/leases/lookup", request, &response); err != nil {
+		return types.LeaseResponse{}, fmt.Errorf("failed to lookup lease %s: %w", leaseID, err)
+	}
+	return response, nil
+}
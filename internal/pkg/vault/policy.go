@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// InstallPolicy creates or updates the named Vault ACL policy.
+func (c *Client) InstallPolicy(token string, policyName string, policyDocument string) error {
+	request := map[string]string{"policy": policyDocument}
+	path := fmt.Sprintf("/v1/sys/policies/acl/%s", policyName)
+	if _, err := c.doRequestWithToken(http.MethodPut, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to install policy %s: %w", policyName, err)
+	}
+	return nil
+}
+
+// CheckSecretEngineInstalled reports whether the given secrets engine is mounted at mountPoint.
+func (c *Client) CheckSecretEngineInstalled(token string, mountPoint string, engine string) (bool, error) {
+	var response struct {
+		Data map[string]struct {
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequestWithToken(http.MethodGet, "/v1/sys/mounts", token, nil, &response); err != nil {
+		return false, fmt.Errorf("failed to list secret engines: %w", err)
+	}
+
+	mount, found := response.Data[fmt.Sprintf("%s/", mountPoint)]
+	return found && mount.Type == engine, nil
+}
+
+// EnableKVSecretEngine enables the key/value secrets engine at mountPoint using the given version.
+func (c *Client) EnableKVSecretEngine(token string, mountPoint string, kvVersion string) error {
+	request := map[string]interface{}{
+		"type": "kv",
+		"options": map[string]string{
+			"version": kvVersion,
+		},
+	}
+	path := fmt.Sprintf("/v1/sys/mounts/%s", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to enable KV secret engine at %s: %w", mountPoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type kvReadResponse struct {
+	Data map[string]string `json:"data"`
+}
+
+type kvListResponse struct {
+	Data struct {
+		Keys []string `json:"keys"`
+	} `json:"data"`
+}
+
+// GetSecrets retrieves secrets from a secret store.
+func (c *Client) GetSecrets(subPath string, keys ...string) (map[string]string, error) {
+	return c.getSecretsFromNamespace(c.namespace, subPath, keys...)
+}
+
+// GetSecretsFromNamespace retrieves secrets from the given Vault namespace, overriding whatever
+// namespace the Client is currently configured to use. This lets a single Client act as a router
+// across tenants without needing one Client instance per namespace.
+func (c *Client) GetSecretsFromNamespace(namespace string, subPath string, keys ...string) (map[string]string, error) {
+	return c.getSecretsFromNamespace(namespace, subPath, keys...)
+}
+
+func (c *Client) getSecretsFromNamespace(namespace string, subPath string, keys ...string) (map[string]string, error) {
+	var response kvReadResponse
+	path := fmt.Sprintf("/v1/%s", subPath)
+	if _, err := c.doRequestWithNamespace(http.MethodGet, path, namespace, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to retrieve secrets from %s: %w", subPath, err)
+	}
+
+	if len(keys) == 0 {
+		return response.Data, nil
+	}
+
+	secrets := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, found := response.Data[key]
+		if !found {
+			return nil, fmt.Errorf("no value for key %s found in path %s", key, subPath)
+		}
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
+
+// StoreSecrets stores the secrets to a secret store.
+func (c *Client) StoreSecrets(subPath string, secrets map[string]string) error {
+	return c.storeSecretsInNamespace(c.namespace, subPath, secrets)
+}
+
+// StoreSecretsInNamespace stores the secrets in the given Vault namespace, overriding whatever
+// namespace the Client is currently configured to use.
+func (c *Client) StoreSecretsInNamespace(namespace string, subPath string, secrets map[string]string) error {
+	return c.storeSecretsInNamespace(namespace, subPath, secrets)
+}
+
+func (c *Client) storeSecretsInNamespace(namespace string, subPath string, secrets map[string]string) error {
+	path := fmt.Sprintf("/v1/%s", subPath)
+	if _, err := c.doRequestWithNamespace(http.MethodPost, path, namespace, secrets, nil); err != nil {
+		return fmt.Errorf("failed to store secrets to %s: %w", subPath, err)
+	}
+	return nil
+}
+
+// getSecretsWithToken behaves like GetSecrets but authenticates with token instead of the
+// Client's own auth token, without mutating shared Client state. It is for admin-style methods
+// that are handed a caller-supplied token rather than relying on the Client's own identity.
+func (c *Client) getSecretsWithToken(token string, subPath string, keys ...string) (map[string]string, error) {
+	var response kvReadResponse
+	path := fmt.Sprintf("/v1/%s", subPath)
+	if _, err := c.doRequestWithToken(http.MethodGet, path, token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to retrieve secrets from %s: %w", subPath, err)
+	}
+
+	if len(keys) == 0 {
+		return response.Data, nil
+	}
+
+	secrets := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, found := response.Data[key]
+		if !found {
+			return nil, fmt.Errorf("no value for key %s found in path %s", key, subPath)
+		}
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
+
+// storeSecretsWithToken behaves like StoreSecrets but authenticates with token instead of the
+// Client's own auth token, without mutating shared Client state.
+func (c *Client) storeSecretsWithToken(token string, subPath string, secrets map[string]string) error {
+	path := fmt.Sprintf("/v1/%s", subPath)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, secrets, nil); err != nil {
+		return fmt.Errorf("failed to store secrets to %s: %w", subPath, err)
+	}
+	return nil
+}
+
+// GetKeys retrieves the keys at the provided sub-path.
+func (c *Client) GetKeys(subPath string) ([]string, error) {
+	var response kvListResponse
+	path := fmt.Sprintf("/v1/%s?list=true", subPath)
+	if _, err := c.doRequest(http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to retrieve keys from %s: %w", subPath, err)
+	}
+	return response.Data.Keys, nil
+}
@@ -0,0 +1,112 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+type tokenCreateResponse struct {
+	Auth map[string]interface{} `json:"auth"`
+}
+
+type tokenLookupResponse struct {
+	Data types.TokenMetadata `json:"data"`
+}
+
+type tokenAccessorListResponse struct {
+	Data struct {
+		Keys []string `json:"keys"`
+	} `json:"data"`
+}
+
+// CreateToken creates a new Vault token using the given parameters.
+func (c *Client) CreateToken(token string, parameters map[string]interface{}) (map[string]interface{}, error) {
+	var response tokenCreateResponse
+	if _, err := c.doRequestWithToken(http.MethodPost, "/v1/auth/token/create", token, parameters, &response); err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	return response.Auth, nil
+}
+
+// ListTokenAccessors lists the accessors of all tokens currently issued by Vault.
+func (c *Client) ListTokenAccessors(token string) ([]string, error) {
+	var response tokenAccessorListResponse
+	if _, err := c.doRequestWithToken(http.MethodGet, "/v1/auth/token/accessors?list=true", token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list token accessors: %w", err)
+	}
+	return response.Data.Keys, nil
+}
+
+// RevokeTokenAccessor revokes the token associated with the given accessor.
+func (c *Client) RevokeTokenAccessor(token string, accessor string) error {
+	request := map[string]string{"accessor": accessor}
+	if _, err := c.doRequestWithToken(http.MethodPost, "/v1/auth/token/revoke-accessor", token, request, nil); err != nil {
+		return fmt.Errorf("failed to revoke token accessor %s: %w", accessor, err)
+	}
+	return nil
+}
+
+// LookupTokenAccessor returns the metadata for the token associated with the given accessor.
+func (c *Client) LookupTokenAccessor(token string, accessor string) (types.TokenMetadata, error) {
+	var response tokenLookupResponse
+	request := map[string]string{"accessor": accessor}
+	if _, err := c.doRequestWithToken(http.MethodPost, "/v1/auth/token/lookup-accessor", token, request, &response); err != nil {
+		return types.TokenMetadata{}, fmt.Errorf("failed to lookup token accessor %s: %w", accessor, err)
+	}
+	return response.Data, nil
+}
+
+// LookupToken returns the metadata for the given token.
+func (c *Client) LookupToken(token string) (types.TokenMetadata, error) {
+	var response tokenLookupResponse
+	request := map[string]string{"token": token}
+	if _, err := c.doRequest(http.MethodPost, "/v1/auth/token/lookup", request, &response); err != nil {
+		return types.TokenMetadata{}, fmt.Errorf("failed to lookup token: %w", err)
+	}
+	return response.Data, nil
+}
+
+// RevokeToken revokes the given token.
+func (c *Client) RevokeToken(token string) error {
+	request := map[string]string{"token": token}
+	if _, err := c.doRequest(http.MethodPost, "/v1/auth/token/revoke", request, nil); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// RegenRootToken regenerates the Vault root token using the given unseal keys.
+func (c *Client) RegenRootToken(keys []string) (string, error) {
+	var rootToken string
+	for _, key := range keys {
+		var response struct {
+			Complete bool   `json:"complete"`
+			Token    string `json:"token"`
+		}
+		request := map[string]string{"key": key}
+		if _, err := c.doRequest(http.MethodPost, "/v1/sys/generate-root/update", request, &response); err != nil {
+			return "", fmt.Errorf("failed to regenerate root token: %w", err)
+		}
+		if response.Complete {
+			rootToken = response.Token
+		}
+	}
+	return rootToken, nil
+}
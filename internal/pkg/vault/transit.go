@@ -0,0 +1,182 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// EnableTransitSecretEngine enables the transit secrets engine at mountPoint.
+func (c *Client) EnableTransitSecretEngine(token string, mountPoint string) error {
+	request := map[string]string{"type": "transit"}
+	path := fmt.Sprintf("/v1/sys/mounts/%s", mountPoint)
+	if _, err := c.doRequestWithToken(http.MethodPost, path, token, request, nil); err != nil {
+		return fmt.Errorf("failed to enable transit secret engine at %s: %w", mountPoint, err)
+	}
+	return nil
+}
+
+func transitPath(mountPoint string, suffix string) string {
+	return fmt.Sprintf("/v1/%s/%s", mountPoint, suffix)
+}
+
+// CreateTransitKey creates a new named key in the transit engine mounted at mountPoint with the
+// given options.
+func (c *Client) CreateTransitKey(mountPoint string, name string, opts types.TransitKeyOptions) error {
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("keys/%s", name)), opts, nil); err != nil {
+		return fmt.Errorf("failed to create transit key %s: %w", name, err)
+	}
+	return nil
+}
+
+// Encrypt encrypts plaintext under the named key in the transit engine mounted at mountPoint,
+// optionally scoped to context for keys created with derivation enabled. keyVersion optionally
+// pins encryption to a prior key version.
+func (c *Client) Encrypt(mountPoint string, keyName string, plaintext []byte, context []byte, keyVersion ...int) (string, error) {
+	request := map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}
+	if len(context) > 0 {
+		request["context"] = base64.StdEncoding.EncodeToString(context)
+	}
+	if len(keyVersion) > 0 {
+		request["key_version"] = keyVersion[0]
+	}
+
+	var response struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("encrypt/%s", keyName)), request, &response); err != nil {
+		return "", fmt.Errorf("failed to encrypt with transit key %s: %w", keyName, err)
+	}
+	return response.Data.Ciphertext, nil
+}
+
+// Decrypt decrypts ciphertext previously produced by Encrypt under the named key in the transit
+// engine mounted at mountPoint. context must match the context supplied at encryption time.
+func (c *Client) Decrypt(mountPoint string, keyName string, ciphertext string, context []byte, keyVersion ...int) ([]byte, error) {
+	request := map[string]interface{}{
+		"ciphertext": ciphertext,
+	}
+	if len(context) > 0 {
+		request["context"] = base64.StdEncoding.EncodeToString(context)
+	}
+	if len(keyVersion) > 0 {
+		request["key_version"] = keyVersion[0]
+	}
+
+	var response struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("decrypt/%s", keyName)), request, &response); err != nil {
+		return nil, fmt.Errorf("failed to decrypt with transit key %s: %w", keyName, err)
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode plaintext returned for transit key %s: %w", keyName, err)
+	}
+	return plaintext, nil
+}
+
+// Rewrap re-encrypts ciphertext under the named key's latest version in the transit engine mounted
+// at mountPoint without exposing the plaintext, letting callers migrate ciphertext forward after a
+// RotateKey.
+func (c *Client) Rewrap(mountPoint string, keyName string, ciphertext string, context []byte) (string, error) {
+	request := map[string]interface{}{
+		"ciphertext": ciphertext,
+	}
+	if len(context) > 0 {
+		request["context"] = base64.StdEncoding.EncodeToString(context)
+	}
+
+	var response struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("rewrap/%s", keyName)), request, &response); err != nil {
+		return "", fmt.Errorf("failed to rewrap ciphertext with transit key %s: %w", keyName, err)
+	}
+	return response.Data.Ciphertext, nil
+}
+
+// Sign produces a signature over input using the named key in the transit engine mounted at
+// mountPoint.
+func (c *Client) Sign(mountPoint string, keyName string, input []byte) (string, error) {
+	request := map[string]interface{}{
+		"input": base64.StdEncoding.EncodeToString(input),
+	}
+
+	var response struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("sign/%s", keyName)), request, &response); err != nil {
+		return "", fmt.Errorf("failed to sign with transit key %s: %w", keyName, err)
+	}
+	return response.Data.Signature, nil
+}
+
+// Verify reports whether signature is a valid signature over input under the named key in the
+// transit engine mounted at mountPoint.
+func (c *Client) Verify(mountPoint string, keyName string, input []byte, signature string) (bool, error) {
+	request := map[string]interface{}{
+		"input":     base64.StdEncoding.EncodeToString(input),
+		"signature": signature,
+	}
+
+	var response struct {
+		Data struct {
+			Valid bool `json:"valid"`
+		} `json:"data"`
+	}
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("verify/%s", keyName)), request, &response); err != nil {
+		return false, fmt.Errorf("failed to verify signature with transit key %s: %w", keyName, err)
+	}
+	return response.Data.Valid, nil
+}
+
+// GenerateDataKey generates a new data encryption key under the named key in the transit engine
+// mounted at mountPoint. keyType is either "plaintext" or "wrapped".
+func (c *Client) GenerateDataKey(mountPoint string, keyName string, keyType string) (types.DataKey, error) {
+	var response struct {
+		Data types.DataKey `json:"data"`
+	}
+	path := transitPath(mountPoint, fmt.Sprintf("datakey/%s/%s", keyType, keyName))
+	if _, err := c.doRequest(http.MethodPost, path, nil, &response); err != nil {
+		return types.DataKey{}, fmt.Errorf("failed to generate data key for transit key %s: %w", keyName, err)
+	}
+	return response.Data, nil
+}
+
+// RotateKey rotates the named key in the transit engine mounted at mountPoint to a new version,
+// retiring the previous version for future encryption while it remains valid for decryption.
+func (c *Client) RotateKey(mountPoint string, keyName string) error {
+	if _, err := c.doRequest(http.MethodPost, transitPath(mountPoint, fmt.Sprintf("keys/%s/rotate", keyName)), nil, nil); err != nil {
+		return fmt.Errorf("failed to rotate transit key %s: %w", keyName, err)
+	}
+	return nil
+}
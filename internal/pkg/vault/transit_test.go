@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+func TestEncryptUsesGivenMountPoint(t *testing.T) {
+	var gotPath string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"ciphertext":"vault:v1:cipher"}}`))
+	})
+
+	ciphertext, err := client.Encrypt("transit-tenant-a", "my-key", []byte("secret"), nil)
+	if err != nil {
+		t.Fatalf("Encrypt returned an error: %v", err)
+	}
+	if gotPath != "/v1/transit-tenant-a/encrypt/my-key" {
+		t.Errorf("expected path to use the given mountPoint, got %q", gotPath)
+	}
+	if ciphertext != "vault:v1:cipher" {
+		t.Errorf("expected ciphertext vault:v1:cipher, got %q", ciphertext)
+	}
+}
+
+func TestDecryptUsesGivenMountPointAndDecodesPlaintext(t *testing.T) {
+	plaintext := []byte("secret")
+	encoded := base64.StdEncoding.EncodeToString(plaintext)
+
+	var gotPath string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"plaintext":"` + encoded + `"}}`))
+	})
+
+	got, err := client.Decrypt("transit-tenant-a", "my-key", "vault:v1:cipher", nil)
+	if err != nil {
+		t.Fatalf("Decrypt returned an error: %v", err)
+	}
+	if gotPath != "/v1/transit-tenant-a/decrypt/my-key" {
+		t.Errorf("expected path to use the given mountPoint, got %q", gotPath)
+	}
+	if string(got) != "secret" {
+		t.Errorf("expected decrypted plaintext %q, got %q", "secret", got)
+	}
+}
+
+func TestEnableTransitSecretEngineUsesGivenTokenWithoutMutatingClient(t *testing.T) {
+	var gotToken string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Vault-Token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := client.EnableTransitSecretEngine("caller-token", "transit-tenant-a"); err != nil {
+		t.Fatalf("EnableTransitSecretEngine returned an error: %v", err)
+	}
+	if gotToken != "caller-token" {
+		t.Errorf("expected X-Vault-Token %q, got %q", "caller-token", gotToken)
+	}
+	if client.authToken != "root-token" {
+		t.Errorf("EnableTransitSecretEngine must not mutate the Client's own auth token, got %q", client.authToken)
+	}
+}
+
+func TestCreateTransitKeyUsesGivenMountPoint(t *testing.T) {
+	var gotPath string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := client.CreateTransitKey("transit-tenant-a", "my-key", types.TransitKeyOptions{Type: "aes256-gcm96"}); err != nil {
+		t.Fatalf("CreateTransitKey returned an error: %v", err)
+	}
+	if gotPath != "/v1/transit-tenant-a/keys/my-key" {
+		t.Errorf("expected path to use the given mountPoint, got %q", gotPath)
+	}
+}
@@ -0,0 +1,129 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package leases provides a background subsystem that keeps Vault leases (e.g. Consul or database
+// credentials) alive by renewing them at a configurable fraction of their TTL, so callers can hold
+// long-lived secrets safely instead of polling for a new one on every request.
+package leases
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
+)
+
+// defaultRenewFraction is used when a LeaseManager is created with a renewFraction outside (0, 1].
+const defaultRenewFraction = 0.5
+
+// RenewalFailureHandler is invoked on a LeaseManager's own goroutine whenever a registered lease
+// fails to renew. err wraps the error returned by the underlying RenewLease call.
+type RenewalFailureHandler func(leaseID string, err error)
+
+// LeaseManager renews a set of registered Vault leases in the background until Stop is called.
+type LeaseManager struct {
+	client        secrets.SecretClient
+	renewFraction float64
+	onFailure     RenewalFailureHandler
+
+	mutex  sync.Mutex
+	leases map[string]chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewLeaseManager creates a LeaseManager that uses client to renew leases, renewing each lease
+// once renewFraction of its current TTL has elapsed. onFailure, if non-nil, is called whenever a
+// renewal attempt fails; the lease is no longer retried after that point.
+func NewLeaseManager(client secrets.SecretClient, renewFraction float64, onFailure RenewalFailureHandler) *LeaseManager {
+	if renewFraction <= 0 || renewFraction > 1 {
+		renewFraction = defaultRenewFraction
+	}
+
+	return &LeaseManager{
+		client:        client,
+		renewFraction: renewFraction,
+		onFailure:     onFailure,
+		leases:        make(map[string]chan struct{}),
+	}
+}
+
+// Register starts renewing leaseID in the background. ttl is the lease's current remaining
+// duration, as returned when the lease was created.
+func (m *LeaseManager) Register(leaseID string, ttl time.Duration) {
+	m.mutex.Lock()
+	if _, exists := m.leases[leaseID]; exists {
+		m.mutex.Unlock()
+		return
+	}
+	stop := make(chan struct{})
+	m.leases[leaseID] = stop
+	m.mutex.Unlock()
+
+	m.wg.Add(1)
+	go m.renewLoop(leaseID, ttl, stop)
+}
+
+// Unregister stops renewing leaseID without revoking it.
+func (m *LeaseManager) Unregister(leaseID string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if stop, exists := m.leases[leaseID]; exists {
+		close(stop)
+		delete(m.leases, leaseID)
+	}
+}
+
+// Stop stops renewing every registered lease and waits for the background goroutines to exit.
+func (m *LeaseManager) Stop() {
+	m.mutex.Lock()
+	for leaseID, stop := range m.leases {
+		close(stop)
+		delete(m.leases, leaseID)
+	}
+	m.mutex.Unlock()
+
+	m.wg.Wait()
+}
+
+func (m *LeaseManager) renewLoop(leaseID string, ttl time.Duration, stop chan struct{}) {
+	defer m.wg.Done()
+
+	for {
+		wait := time.Duration(float64(ttl) * m.renewFraction)
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		response, err := m.client.RenewLease(leaseID, ttl)
+		if err != nil {
+			m.mutex.Lock()
+			delete(m.leases, leaseID)
+			m.mutex.Unlock()
+
+			if m.onFailure != nil {
+				m.onFailure(leaseID, fmt.Errorf("failed to renew lease %s: %w", leaseID, err))
+			}
+			return
+		}
+
+		ttl = time.Duration(response.LeaseDuration) * time.Second
+	}
+}
@@ -0,0 +1,108 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package leases
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
+)
+
+// fakeSecretClient embeds the full secrets.SecretClient interface (nil) and overrides only
+// RenewLease, which is all LeaseManager needs.
+type fakeSecretClient struct {
+	secrets.SecretClient
+	renewLease func(leaseID string, increment time.Duration) (types.LeaseResponse, error)
+}
+
+func (f *fakeSecretClient) RenewLease(leaseID string, increment time.Duration) (types.LeaseResponse, error) {
+	return f.renewLease(leaseID, increment)
+}
+
+func TestRenewLoopSucceedsRepeatedly(t *testing.T) {
+	renewedCh := make(chan struct{}, 10)
+
+	client := &fakeSecretClient{
+		renewLease: func(leaseID string, increment time.Duration) (types.LeaseResponse, error) {
+			// Never block: once the buffer is full further renewals are dropped, so the renew
+			// loop can always return to its select and observe Stop.
+			select {
+			case renewedCh <- struct{}{}:
+			default:
+			}
+			// LeaseDuration is in seconds, so this keeps each subsequent wait short enough for
+			// the test to observe multiple renewals without a long sleep.
+			return types.LeaseResponse{LeaseDuration: 0}, nil
+		},
+	}
+
+	manager := NewLeaseManager(client, 1, nil)
+	manager.Register("lease-1", 5*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-renewedCh:
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for renewal %d", i+1)
+		}
+	}
+
+	manager.Stop()
+}
+
+func TestRenewLoopRemovesLeaseOnFailureSoItCanBeReRegistered(t *testing.T) {
+	var renewCount int32
+	failureCh := make(chan string, 10)
+
+	client := &fakeSecretClient{
+		renewLease: func(leaseID string, increment time.Duration) (types.LeaseResponse, error) {
+			atomic.AddInt32(&renewCount, 1)
+			return types.LeaseResponse{}, errors.New("renewal failed")
+		},
+	}
+
+	manager := NewLeaseManager(client, 1, func(leaseID string, err error) {
+		failureCh <- leaseID
+	})
+
+	manager.Register("lease-1", 5*time.Millisecond)
+
+	select {
+	case <-failureCh:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the first renewal failure")
+	}
+
+	// Registering again must not be a no-op: the failed renewal must have removed the lease from
+	// the manager's internal map, otherwise this lease is permanently blackholed.
+	manager.Register("lease-1", 5*time.Millisecond)
+
+	select {
+	case <-failureCh:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a second renewal failure after re-registering the lease")
+	}
+
+	manager.Stop()
+
+	if atomic.LoadInt32(&renewCount) < 2 {
+		t.Fatalf("expected at least 2 renewal attempts across both registrations, got %d", renewCount)
+	}
+}
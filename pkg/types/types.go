@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package types
+
+import "time"
+
+// SecretConfig contains configuration settings used to communicate with a secret store provider.
+type SecretConfig struct {
+	Type           string
+	Protocol       string
+	Host           string
+	Port           int
+	Path           string
+	RootCaCertPath string
+	ServerName     string
+	Authentication AuthenticationInfo
+	TokenFile      string
+	// Namespace is the Vault Enterprise namespace to operate against. When empty the root namespace is used.
+	Namespace               string
+	AdditionalRetryAttempts int
+	RetryWaitPeriod         string
+}
+
+// AuthenticationInfo contains authentication details when communicating with a secret store provider.
+type AuthenticationInfo struct {
+	AuthType  string
+	AuthToken string
+}
+
+// InitResponse contains the root token and unseal keys returned from initializing a Vault instance.
+type InitResponse struct {
+	Keys       []string `json:"keys"`
+	KeysBase64 []string `json:"keys_base64"`
+	RootToken  string   `json:"root_token"`
+}
+
+// TokenMetadata contains the metadata associated with a Vault token, as returned by lookup endpoints.
+type TokenMetadata struct {
+	Accessor       string            `json:"accessor"`
+	CreationTime   int64             `json:"creation_time"`
+	CreationTTL    int64             `json:"creation_ttl"`
+	DisplayName    string            `json:"display_name"`
+	EntityID       string            `json:"entity_id"`
+	ExpireTime     string            `json:"expire_time"`
+	ExplicitMaxTTL int64             `json:"explicit_max_ttl"`
+	ID             string            `json:"id"`
+	IssueTime      string            `json:"issue_time"`
+	Meta           map[string]string `json:"meta"`
+	NumUses        int               `json:"num_uses"`
+	Orphan         bool              `json:"orphan"`
+	Path           string            `json:"path"`
+	Policies       []string          `json:"policies"`
+	Renewable      bool              `json:"renewable"`
+	TTL            int64             `json:"ttl"`
+	Type           string            `json:"type"`
+}
+
+// ConsulRole models the role document used by Vault's Consul secrets engine to mint Consul tokens.
+type ConsulRole struct {
+	Name              string        `json:"name"`
+	Policies          []string      `json:"policies,omitempty"`
+	ConsulPolicies    []string      `json:"consul_policies,omitempty"`
+	ConsulRoles       []string      `json:"consul_roles,omitempty"`
+	ServiceIdentities []string      `json:"service_identities,omitempty"`
+	NodeIdentities    []string      `json:"node_identities,omitempty"`
+	Namespace         string        `json:"namespace,omitempty"`
+	Partition         string        `json:"partition,omitempty"`
+	Local             bool          `json:"local,omitempty"`
+	TTL               time.Duration `json:"ttl,omitempty"`
+	MaxTTL            time.Duration `json:"max_ttl,omitempty"`
+	// TokenType is either "client" or "management".
+	TokenType string `json:"token_type,omitempty"`
+}
+
+// LeaseResponse models the lease metadata Vault returns from its sys/leases endpoints.
+type LeaseResponse struct {
+	LeaseID       string `json:"lease_id"`
+	Renewable     bool   `json:"renewable"`
+	LeaseDuration int    `json:"lease_duration"`
+}
+
+// ConsulTokenResponse models the full lease Vault returns when minting a Consul token, letting
+// the caller renew or revoke it rather than always minting a new one. Data holds the Consul
+// "token" (the SecretID) and "accessor" returned alongside the lease.
+type ConsulTokenResponse struct {
+	LeaseID       string            `json:"lease_id"`
+	Renewable     bool              `json:"renewable"`
+	LeaseDuration int               `json:"lease_duration"`
+	Data          map[string]string `json:"data"`
+}
+
+// KubernetesAuthConfig models the configuration of Vault's Kubernetes auth backend, as submitted
+// to the backend's config endpoint.
+type KubernetesAuthConfig struct {
+	// KubernetesHost is the URL of the Kubernetes API server, e.g. https://kubernetes.default.svc:443
+	KubernetesHost string `json:"kubernetes_host"`
+	// KubernetesCACert is the PEM encoded CA certificate used to validate connections to the
+	// Kubernetes API server.
+	KubernetesCACert string `json:"kubernetes_ca_cert,omitempty"`
+	// Issuer is the expected issuer of the Kubernetes service account JWTs, required when using
+	// projected service account tokens.
+	Issuer string `json:"issuer,omitempty"`
+	// TokenReviewerJWT is an optional JWT for a service account with access to the TokenReview API
+	// used by Vault to validate the JWTs presented at login.
+	TokenReviewerJWT string `json:"token_reviewer_jwt,omitempty"`
+}
+
+// KubernetesRole models a role bound to the Vault Kubernetes auth backend, controlling which
+// service accounts may authenticate as the role and what they receive upon login.
+type KubernetesRole struct {
+	BoundServiceAccountNames      []string `json:"bound_service_account_names"`
+	BoundServiceAccountNamespaces []string `json:"bound_service_account_namespaces"`
+	TokenTTL                      int64    `json:"token_ttl,omitempty"`
+	TokenPolicies                 []string `json:"token_policies,omitempty"`
+	Audience                      string   `json:"audience,omitempty"`
+}
+
+// BootStrapACLTokenInfo models the response returned by Consul's acl/bootstrap endpoint,
+// containing the initial management token minted for the cluster.
+type BootStrapACLTokenInfo struct {
+	AccessorID  string   `json:"AccessorID"`
+	SecretID    string   `json:"SecretID"`
+	Description string   `json:"Description"`
+	Policies    []Policy `json:"Policies"`
+	Local       bool     `json:"Local"`
+	CreateTime  string   `json:"CreateTime"`
+}
+
+// Policy models a Consul ACL policy as referenced by a Consul ACL token.
+type Policy struct {
+	ID   string `json:"ID"`
+	Name string `json:"Name"`
+}
+
+// TransitKeyOptions controls how a named key is created in Vault's transit secrets engine.
+type TransitKeyOptions struct {
+	// Type is the key algorithm, e.g. "aes256-gcm96", "rsa-4096", "ecdsa-p256".
+	Type string `json:"type,omitempty"`
+	// Exportable allows the key to later be exported via the transit engine's export endpoint.
+	Exportable bool `json:"exportable,omitempty"`
+	// AllowPlaintextBackup allows the key to be backed up in plaintext via the backup endpoint.
+	AllowPlaintextBackup bool `json:"allow_plaintext_backup,omitempty"`
+	// Derived allows the key to require derivation, enabling per-context key derivation.
+	Derived bool `json:"derived,omitempty"`
+}
+
+// DataKey is a data encryption key generated by Vault's transit engine for use outside of Vault.
+// Plaintext is only present when the caller requested a "plaintext" data key; for a "wrapped" key
+// only Ciphertext is populated.
+type DataKey struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext"`
+}
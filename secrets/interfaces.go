@@ -17,6 +17,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
 )
@@ -30,6 +31,11 @@ type SecretClient interface {
 	// specified path will be returned.
 	GetSecrets(subPath string, keys ...string) (map[string]string, error)
 
+	// GetSecretsFromNamespace retrieves secrets from the given Vault namespace, overriding the
+	// namespace set via SetNamespace for this call only. This allows a single client to act as a
+	// tenant router across multiple Vault Enterprise namespaces.
+	GetSecretsFromNamespace(namespace string, subPath string, keys ...string) (map[string]string, error)
+
 	// StoreSecrets stores the secrets to a secret store.
 	// it sets the values requested at provided keys
 	// subPath specifies the type or location of the secrets to store. If specified it is appended
@@ -37,6 +43,11 @@ type SecretClient interface {
 	// secrets map specifies the "key": "value" pairs of secrets to store
 	StoreSecrets(subPath string, secrets map[string]string) error
 
+	// StoreSecretsInNamespace stores the secrets in the given Vault namespace, overriding the
+	// namespace set via SetNamespace for this call only. This allows a single client to act as a
+	// tenant router across multiple Vault Enterprise namespaces.
+	StoreSecretsInNamespace(namespace string, subPath string, secrets map[string]string) error
+
 	// GenerateConsulToken generates a new Consul token based on the given serviceKey
 	// it uses a secret store token from config and requires the permission to generate a Consul token
 	// the Consul token is like a bearer token and is used to access the information from Consul
@@ -46,6 +57,11 @@ type SecretClient interface {
 	// tokens stored in Consul server side and the number of calls to this API
 	GenerateConsulToken(serviceKey string) (string, error)
 
+	// GenerateConsulTokenForRole generates a new Consul token for roleName and returns the full
+	// lease (accessor, lease ID, lease duration, renewable) so the caller can renew or revoke it
+	// instead of always minting a new token.
+	GenerateConsulTokenForRole(roleName string) (types.ConsulTokenResponse, error)
+
 	// SetAuthToken sets the internal Auth Token with the new value specified.
 	SetAuthToken(ctx context.Context, token string) error
 
@@ -58,6 +74,61 @@ type SecretClient interface {
 
 	// IsJWTValid evaluates a given JWT and returns a true/false if the JWT is valid (i.e. belongs to us and current) or not
 	IsJWTValid(jwt string) (bool, error)
+
+	// RenewLease renews the lease identified by leaseID by increment, returning the new lease
+	// duration Vault granted. Used to keep a long-lived Consul or database credential alive instead
+	// of letting it expire and minting a new one.
+	RenewLease(leaseID string, increment time.Duration) (types.LeaseResponse, error)
+
+	// RevokeLease revokes the lease identified by leaseID immediately.
+	RevokeLease(leaseID string) error
+
+	// LookupLease returns the current metadata for the lease identified by leaseID.
+	LookupLease(leaseID string) (types.LeaseResponse, error)
+
+	// SetNamespace sets the Vault Enterprise namespace used for all subsequent requests made by
+	// this client. Passing an empty string reverts to the root namespace.
+	SetNamespace(namespace string)
+}
+
+// TransitClient provides a contract for using a secret store's transit/encryption-as-a-service
+// engine to protect data without the caller having to manage its own key encryption keys.
+type TransitClient interface {
+	// CreateTransitKey creates a new named key in the transit engine mounted at mountPoint with the
+	// given options.
+	CreateTransitKey(mountPoint string, name string, opts types.TransitKeyOptions) error
+
+	// Encrypt encrypts plaintext under the named key in the transit engine mounted at mountPoint,
+	// optionally scoped to context for keys created with derivation enabled. keyVersion optionally
+	// pins encryption to a prior key version; when omitted the latest version is used.
+	Encrypt(mountPoint string, keyName string, plaintext []byte, context []byte, keyVersion ...int) (ciphertext string, err error)
+
+	// Decrypt decrypts ciphertext previously produced by Encrypt under the named key in the
+	// transit engine mounted at mountPoint. context must match the context supplied at encryption
+	// time.
+	Decrypt(mountPoint string, keyName string, ciphertext string, context []byte, keyVersion ...int) ([]byte, error)
+
+	// Rewrap re-encrypts ciphertext under the named key's latest version in the transit engine
+	// mounted at mountPoint without exposing the plaintext, letting callers migrate ciphertext
+	// forward after a RotateKey.
+	Rewrap(mountPoint string, keyName string, ciphertext string, context []byte) (string, error)
+
+	// Sign produces a signature over input using the named key in the transit engine mounted at
+	// mountPoint.
+	Sign(mountPoint string, keyName string, input []byte) (signature string, err error)
+
+	// Verify reports whether signature is a valid signature over input under the named key in the
+	// transit engine mounted at mountPoint.
+	Verify(mountPoint string, keyName string, input []byte, signature string) (bool, error)
+
+	// GenerateDataKey generates a new data encryption key under the named key in the transit
+	// engine mounted at mountPoint. keyType is either "plaintext" or "wrapped".
+	GenerateDataKey(mountPoint string, keyName string, keyType string) (types.DataKey, error)
+
+	// RotateKey rotates the named key in the transit engine mounted at mountPoint to a new
+	// version, retiring the previous version for future encryption while it remains valid for
+	// decryption.
+	RotateKey(mountPoint string, keyName string) error
 }
 
 // SecretStoreClient provides a contract for managing a Secret Store from a secret store provider.
@@ -77,7 +148,27 @@ type SecretStoreClient interface {
 	LookupToken(token string) (types.TokenMetadata, error)
 	RevokeToken(token string) error
 	ConfigureConsulAccess(secretStoreToken string, bootstrapACLToken string, consulHost string, consulPort int) error
-	CreateRole(secretStoreToken string, consulRole types.ConsulRole) error
+	CreateRole(secretStoreToken string, mountPoint string, consulRole types.ConsulRole) error
+
+	// ReadConsulRole reads the named role from the Consul secrets engine mounted at mountPoint.
+	ReadConsulRole(token string, mountPoint string, roleName string) (types.ConsulRole, error)
+
+	// ListConsulRoles lists the names of the roles configured on the Consul secrets engine mounted
+	// at mountPoint.
+	ListConsulRoles(token string, mountPoint string) ([]string, error)
+
+	// DeleteConsulRole deletes the named role from the Consul secrets engine mounted at mountPoint.
+	DeleteConsulRole(token string, mountPoint string, roleName string) error
+
+	// BootstrapConsulACL calls Consul's acl/bootstrap endpoint directly on the Consul agent at
+	// consulHost:consulPort, returning the initial management token minted for the cluster.
+	BootstrapConsulACL(consulHost string, consulPort int) (types.BootStrapACLTokenInfo, error)
+
+	// BootstrapAndConfigureConsul bootstraps Consul's ACL system, persists the resulting
+	// management token's SecretID into the secret store at subPath, and configures the Consul
+	// secrets engine to use it. If Consul has already been bootstrapped, the existing SecretID is
+	// read back from subPath instead, so re-running setup does not orphan or re-bootstrap tokens.
+	BootstrapAndConfigureConsul(secretStoreToken string, subPath string, consulHost string, consulPort int) (string, error)
 	CreateOrUpdateIdentity(token string, name string, metadata map[string]string, policies []string) (string, error)
 	DeleteIdentity(token string, name string) error
 	LookupIdentity(token string, name string) (string, error)
@@ -91,4 +182,27 @@ type SecretStoreClient interface {
 	CheckIdentityKeyExists(token string, keyName string) (bool, error)
 	CreateNamedIdentityKey(token string, keyName string, algorithm string) error
 	CreateOrUpdateIdentityRole(token string, roleName string, keyName string, template string, jwtTTL string) error
+
+	// EnableKubernetesAuth enables the Kubernetes auth method at mountPoint.
+	EnableKubernetesAuth(token string, mountPoint string) error
+
+	// ConfigureKubernetesAuth configures the Kubernetes auth method mounted at mountPoint with the
+	// location of the Kubernetes API server and the details needed to validate service account JWTs.
+	ConfigureKubernetesAuth(token string, mountPoint string, cfg types.KubernetesAuthConfig) error
+
+	// CreateOrUpdateKubernetesRole creates or updates a role on the Kubernetes auth method mounted
+	// at mountPoint, binding the service accounts allowed to authenticate as roleName.
+	CreateOrUpdateKubernetesRole(token string, mountPoint string, roleName string, role types.KubernetesRole) error
+
+	// LoginWithKubernetes authenticates jwt, a Kubernetes service account token, against role on
+	// the Kubernetes auth method mounted at mountPoint and returns the "auth" section of the login
+	// response (which holds the client_token).
+	LoginWithKubernetes(mountPoint string, role string, jwt string) (map[string]interface{}, error)
+
+	// EnableTransitSecretEngine enables the transit secrets engine at mountPoint.
+	EnableTransitSecretEngine(token string, mountPoint string) error
+
+	// SetNamespace sets the Vault Enterprise namespace used for all subsequent requests made by
+	// this client. Passing an empty string reverts to the root namespace.
+	SetNamespace(namespace string)
 }
@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2019 Dell Inc.
+ * Copyright 2021 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/internal/pkg/vault"
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+)
+
+// defaultKubernetesAuthMountPoint is the mount point Vault's Kubernetes auth method is enabled at
+// by default.
+const defaultKubernetesAuthMountPoint = "kubernetes"
+
+// NewSecretClient creates a new Vault backed SecretClient using the given configuration and an
+// already-acquired auth token.
+func NewSecretClient(config types.SecretConfig, authToken string) SecretClient {
+	return vault.NewClient(config, http.DefaultClient, authToken)
+}
+
+// NewSecretStoreClient creates a new Vault backed SecretStoreClient using the given configuration
+// and an already-acquired auth token.
+func NewSecretStoreClient(config types.SecretConfig, authToken string) SecretStoreClient {
+	return vault.NewClient(config, http.DefaultClient, authToken)
+}
+
+// NewTransitClient creates a new Vault backed TransitClient using the given configuration and an
+// already-acquired auth token, reusing the Vault instance already bootstrapped for KV secrets.
+func NewTransitClient(config types.SecretConfig, authToken string) TransitClient {
+	return vault.NewClient(config, http.DefaultClient, authToken)
+}
+
+// NewClientFromKubernetesAuth creates a new Vault backed SecretClient by authenticating via
+// Vault's Kubernetes auth method, reading the service account JWT from jwtPath and logging in as
+// role, rather than requiring a pre-provisioned root/admin token.
+func NewClientFromKubernetesAuth(ctx context.Context, config types.SecretConfig, role string, jwtPath string) (SecretClient, error) {
+	jwt, err := os.ReadFile(jwtPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Kubernetes service account token from %s: %w", jwtPath, err)
+	}
+
+	client := vault.NewClient(config, http.DefaultClient, "")
+	auth, err := client.LoginWithKubernetes(defaultKubernetesAuthMountPoint, role, string(jwt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to login via Kubernetes auth as role %s: %w", role, err)
+	}
+
+	clientToken, ok := auth["client_token"].(string)
+	if !ok || clientToken == "" {
+		return nil, fmt.Errorf("Kubernetes auth login for role %s did not return a client token", role)
+	}
+
+	if err := client.SetAuthToken(ctx, clientToken); err != nil {
+		return nil, fmt.Errorf("failed to set auth token from Kubernetes login: %w", err)
+	}
+
+	return client, nil
+}